@@ -0,0 +1,31 @@
+// Command openshift-install is the CLI entrypoint for installing and
+// managing OpenShift clusters.
+//
+// NOTE: this checkout of the installer only carries the subset of the
+// tree touched by this change series; the rest of the real CLI (create
+// cluster, destroy cluster, wait-for, version, ...) lives upstream and
+// isn't reconstructed here.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	if err := newRootCmd().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func newRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "openshift-install",
+		Short: "Creates OpenShift clusters",
+	}
+	cmd.AddCommand(newManifestsCmd())
+	return cmd
+}
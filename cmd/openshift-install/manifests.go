@@ -0,0 +1,80 @@
+package main
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/manifests"
+	"github.com/openshift/installer/pkg/asset/store"
+)
+
+// newManifestsCmd returns the "manifests" command, which renders the
+// cluster's Kubernetes manifests into the working directory. With
+// --verify, it instead renders the manifests twice, independently, and
+// confirms the two renders hash identically - the check that backs
+// reproducible rendering (install-config.yaml's reproducibleRender
+// stanza, or INSTALLER_SEED) - writing nothing to disk either way.
+func newManifestsCmd() *cobra.Command {
+	var verify bool
+	cmd := &cobra.Command{
+		Use:   "manifests",
+		Short: "Generates the Kubernetes manifests for the cluster",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if verify {
+				return runManifestsVerify(".")
+			}
+			return runManifestsGenerate(".")
+		},
+	}
+	cmd.Flags().BoolVar(&verify, "verify", false, "re-render the manifests twice and confirm they hash identically, instead of writing them")
+	return cmd
+}
+
+func runManifestsGenerate(directory string) error {
+	assetStore, err := store.NewStore(directory)
+	if err != nil {
+		return errors.Wrap(err, "failed to create asset store")
+	}
+
+	m := &manifests.Manifests{}
+	if err := assetStore.Fetch(m); err != nil {
+		return errors.Wrap(err, "failed to generate manifests")
+	}
+
+	if err := asset.PersistToFile(m, directory); err != nil {
+		return errors.Wrap(err, "failed to write manifests to disk")
+	}
+	return nil
+}
+
+func runManifestsVerify(directory string) error {
+	first, err := renderManifests(directory)
+	if err != nil {
+		return errors.Wrap(err, "failed first render")
+	}
+
+	second, err := renderManifests(directory)
+	if err != nil {
+		return errors.Wrap(err, "failed second render")
+	}
+
+	firstHash, secondHash := first.HashFiles(), second.HashFiles()
+	if firstHash != secondHash {
+		return errors.Errorf("manifests are not reproducible: got %s on the first render and %s on the second", firstHash, secondHash)
+	}
+	return nil
+}
+
+func renderManifests(directory string) (*manifests.Manifests, error) {
+	assetStore, err := store.NewStore(directory)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create asset store")
+	}
+
+	m := &manifests.Manifests{}
+	if err := assetStore.Fetch(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
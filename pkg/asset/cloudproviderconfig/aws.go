@@ -0,0 +1,18 @@
+package cloudproviderconfig
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/types"
+)
+
+// awsCloudConfig renders the cloud.conf for the AWS cloud provider. AWS
+// nodes authenticate via their instance profile, so there is no
+// credentials secret to accompany it.
+func awsCloudConfig(p *types.AWSPlatform) (string, map[string]string, error) {
+	if p.Region == "" {
+		return "", nil, errors.New("aws: region is required")
+	}
+
+	return "[Global]\n", nil, nil
+}
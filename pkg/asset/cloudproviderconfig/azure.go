@@ -0,0 +1,60 @@
+package cloudproviderconfig
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/types"
+)
+
+// azureCloudConfig renders the azure.json consumed by the Azure cloud
+// provider. tenantID, subscriptionID and resourceGroup come straight from
+// the Azure platform scoping the cluster's resources and cannot be
+// defaulted, so Generate fails early rather than shipping a cloud.conf
+// the provider will reject at runtime.
+func azureCloudConfig(p *types.AzurePlatform) (string, map[string]string, error) {
+	var missing []string
+	if p.TenantID == "" {
+		missing = append(missing, "tenantID")
+	}
+	if p.SubscriptionID == "" {
+		missing = append(missing, "subscriptionID")
+	}
+	if p.ResourceGroup == "" {
+		missing = append(missing, "resourceGroup")
+	}
+	if p.Region == "" {
+		missing = append(missing, "region")
+	}
+	if len(missing) > 0 {
+		return "", nil, errors.Errorf("azure: missing required field(s): %v", missing)
+	}
+
+	conf := struct {
+		Cloud                       string `json:"cloud"`
+		TenantID                    string `json:"tenantId"`
+		SubscriptionID              string `json:"subscriptionId"`
+		ResourceGroup               string `json:"resourceGroup"`
+		Location                    string `json:"location"`
+		UseManagedIdentityExtension bool   `json:"useManagedIdentityExtension"`
+		UseInstanceMetadata         bool   `json:"useInstanceMetadata"`
+		LoadBalancerSku             string `json:"loadBalancerSku"`
+	}{
+		Cloud:                       "AzurePublicCloud",
+		TenantID:                    p.TenantID,
+		SubscriptionID:              p.SubscriptionID,
+		ResourceGroup:               p.ResourceGroup,
+		Location:                    p.Region,
+		UseManagedIdentityExtension: true,
+		UseInstanceMetadata:         true,
+		LoadBalancerSku:             "standard",
+	}
+
+	data, err := json.MarshalIndent(conf, "", "  ")
+	if err != nil {
+		return "", nil, errors.Wrap(err, "azure: failed to marshal cloud.conf")
+	}
+
+	return string(data), nil, nil
+}
@@ -0,0 +1,181 @@
+// Package cloudproviderconfig generates the cloud.conf consumed by the
+// in-tree and CSI cloud providers, along with the manifests that deliver
+// it (and any platform credentials it depends on) to the cluster.
+package cloudproviderconfig
+
+import (
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+	"github.com/openshift/installer/pkg/types"
+)
+
+const (
+	cloudProviderConfigNamespace = "kube-system"
+	cloudProviderConfigName      = "cloud-provider-config"
+	cloudProviderConfigManifest  = "cloud-provider-config-configmap.yaml"
+	cloudProviderSecretManifest  = "cloud-provider-credentials-secret.yaml"
+)
+
+// CloudProviderConfig generates the cloud.conf file and accompanying
+// manifests for the cluster's platform.
+type CloudProviderConfig struct {
+	// ConfigRaw is the rendered cloud.conf contents for the platform, or
+	// empty if the platform has no cloud provider integration.
+	ConfigRaw []byte
+	FileList  []*asset.File
+}
+
+var _ asset.WritableAsset = (*CloudProviderConfig)(nil)
+
+// Name returns a human friendly name for the asset.
+func (a *CloudProviderConfig) Name() string {
+	return "Cloud Provider Config"
+}
+
+// Dependencies returns all of the dependencies directly needed by a
+// CloudProviderConfig asset.
+func (a *CloudProviderConfig) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+	}
+}
+
+// Generate generates the cloud.conf and its manifests for the installed
+// platform.
+func (a *CloudProviderConfig) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	dependencies.Get(installConfig)
+
+	conf, credentials, err := cloudConfigForPlatform(installConfig.Config.Platform)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate cloud provider config")
+	}
+	a.ConfigRaw = []byte(conf)
+
+	if conf == "" {
+		a.FileList = nil
+		return nil
+	}
+
+	cmFile, err := configMapFile(conf)
+	if err != nil {
+		return errors.Wrap(err, "failed to create cloud-provider-config configmap")
+	}
+	a.FileList = []*asset.File{cmFile}
+
+	if len(credentials) > 0 {
+		secretFile, err := credentialsSecretFile(credentials)
+		if err != nil {
+			return errors.Wrap(err, "failed to create cloud-provider-config credentials secret")
+		}
+		a.FileList = append(a.FileList, secretFile)
+	}
+
+	return nil
+}
+
+// Load reads the cloud-provider-config manifests back from disk.
+func (a *CloudProviderConfig) Load(f asset.FileFetcher) (bool, error) {
+	cmFile, err := f.FetchByName(filepath.Join("manifests", cloudProviderConfigManifest))
+	if err != nil {
+		return false, nil
+	}
+
+	cm := &configMapObject{}
+	if err := yaml.Unmarshal(cmFile.Data, cm); err != nil {
+		return false, errors.Wrapf(err, "failed to unmarshal %s", cloudProviderConfigManifest)
+	}
+
+	fileList := []*asset.File{cmFile}
+	if secretFile, err := f.FetchByName(filepath.Join("manifests", cloudProviderSecretManifest)); err == nil {
+		fileList = append(fileList, secretFile)
+	}
+
+	a.ConfigRaw = []byte(cm.Data["cloud.conf"])
+	a.FileList = fileList
+
+	return true, nil
+}
+
+// Files returns the files generated by the asset.
+func (a *CloudProviderConfig) Files() []*asset.File {
+	return a.FileList
+}
+
+// cloudConfigForPlatform dispatches to the per-platform cloud.conf builder
+// for whichever platform is configured. It returns the rendered cloud.conf
+// and, where the platform needs one, the data for a credentials Secret.
+// An unset platform (e.g. libvirt, or a platform-less dev install) yields
+// an empty cloud.conf rather than an error.
+func cloudConfigForPlatform(platform types.Platform) (string, map[string]string, error) {
+	switch {
+	case platform.AWS != nil:
+		return awsCloudConfig(platform.AWS)
+	case platform.Azure != nil:
+		return azureCloudConfig(platform.Azure)
+	case platform.GCP != nil:
+		return gcpCloudConfig(platform.GCP)
+	case platform.OpenStack != nil:
+		return openStackCloudConfig(platform.OpenStack)
+	case platform.VSphere != nil:
+		return vsphereCloudConfig(platform.VSphere)
+	default:
+		return "", nil, nil
+	}
+}
+
+type configMapObject struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   configMapMetadata `json:"metadata"`
+	Data       map[string]string `json:"data"`
+}
+
+type configMapMetadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+func configMapFile(conf string) (*asset.File, error) {
+	cm := &configMapObject{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Metadata:   configMapMetadata{Name: cloudProviderConfigName, Namespace: cloudProviderConfigNamespace},
+		Data: map[string]string{
+			"cloud.conf": conf,
+		},
+	}
+	data, err := yaml.Marshal(cm)
+	if err != nil {
+		return nil, err
+	}
+	return &asset.File{Filename: filepath.Join("manifests", cloudProviderConfigManifest), Data: data}, nil
+}
+
+type secretObject struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Type       string            `json:"type"`
+	Metadata   configMapMetadata `json:"metadata"`
+	StringData map[string]string `json:"stringData"`
+}
+
+func credentialsSecretFile(credentials map[string]string) (*asset.File, error) {
+	secret := &secretObject{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Type:       "Opaque",
+		Metadata:   configMapMetadata{Name: cloudProviderConfigName + "-credentials", Namespace: cloudProviderConfigNamespace},
+		StringData: credentials,
+	}
+	data, err := yaml.Marshal(secret)
+	if err != nil {
+		return nil, err
+	}
+	return &asset.File{Filename: filepath.Join("manifests", cloudProviderSecretManifest), Data: data}, nil
+}
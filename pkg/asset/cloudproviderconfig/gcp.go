@@ -0,0 +1,21 @@
+package cloudproviderconfig
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/types"
+)
+
+// gcpCloudConfig renders the cloud.conf for the GCP cloud provider.
+// Credentials are supplied to the cluster out of band (the GCP
+// cloud-credential-operator secret), so there is no secret to emit here.
+func gcpCloudConfig(p *types.GCPPlatform) (string, map[string]string, error) {
+	if p.ProjectID == "" {
+		return "", nil, errors.New("gcp: projectID is required")
+	}
+
+	conf := fmt.Sprintf("[global]\nproject-id = %s\nregional = true\n", p.ProjectID)
+	return conf, nil, nil
+}
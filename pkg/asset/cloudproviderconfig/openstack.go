@@ -0,0 +1,26 @@
+package cloudproviderconfig
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/types"
+)
+
+// openStackCloudConfig renders the cloud.conf for the OpenStack cloud
+// provider. Authentication is delegated to clouds.yaml (named by Cloud),
+// which is mounted into the cluster separately, so no credentials secret
+// is produced here.
+func openStackCloudConfig(p *types.OpenStackPlatform) (string, map[string]string, error) {
+	if p.Cloud == "" {
+		return "", nil, errors.New("openstack: cloud is required")
+	}
+
+	conf := fmt.Sprintf("[Global]\nuse-clouds = true\nclouds-file = /etc/openstack/clouds.yaml\ncloud = %s\n", p.Cloud)
+	if p.ExternalNetwork != "" {
+		conf += fmt.Sprintf("\n[LoadBalancer]\nfloating-network-id = %s\n", p.ExternalNetwork)
+	}
+
+	return conf, nil, nil
+}
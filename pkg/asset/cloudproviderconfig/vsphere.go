@@ -0,0 +1,57 @@
+package cloudproviderconfig
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/types"
+)
+
+// vsphereCloudConfig renders the cloud.conf for the vSphere cloud
+// provider and the username/password credentials it references, which
+// are delivered separately as a Secret rather than embedded in the
+// ConfigMap.
+func vsphereCloudConfig(p *types.VSpherePlatform) (string, map[string]string, error) {
+	var missing []string
+	if p.VCenter == "" {
+		missing = append(missing, "vCenter")
+	}
+	if p.Datacenter == "" {
+		missing = append(missing, "datacenter")
+	}
+	if p.DefaultDatastore == "" {
+		missing = append(missing, "defaultDatastore")
+	}
+	if p.Username == "" {
+		missing = append(missing, "username")
+	}
+	if p.Password == "" {
+		missing = append(missing, "password")
+	}
+	if len(missing) > 0 {
+		return "", nil, errors.Errorf("vsphere: missing required field(s): %v", missing)
+	}
+
+	conf := fmt.Sprintf(`[Global]
+secret-name = "%s-credentials"
+secret-namespace = "%s"
+insecure-flag = "1"
+
+[VirtualCenter "%s"]
+datacenters = "%s"
+
+[Workspace]
+server = "%s"
+datacenter = "%s"
+default-datastore = "%s"
+folder = "vm"
+`, cloudProviderConfigName, cloudProviderConfigNamespace, p.VCenter, p.Datacenter, p.VCenter, p.Datacenter, p.DefaultDatastore)
+
+	credentials := map[string]string{
+		fmt.Sprintf("%s.username", p.VCenter): p.Username,
+		fmt.Sprintf("%s.password", p.VCenter): p.Password,
+	}
+
+	return conf, credentials, nil
+}
@@ -0,0 +1,77 @@
+package installconfig
+
+import (
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+)
+
+const clusterIDPath = ".openshift_install_state/cluster-id"
+
+// ClusterID is the asset that generates the unique cluster ID persisted in
+// the CVO's ClusterVersion resource.
+type ClusterID struct {
+	ClusterID string
+	FileList  []*asset.File
+}
+
+var _ asset.WritableAsset = (*ClusterID)(nil)
+
+// Name returns a human friendly name for the asset.
+func (a *ClusterID) Name() string {
+	return "Cluster ID"
+}
+
+// Dependencies returns all of the dependencies directly needed by a
+// ClusterID asset.
+func (a *ClusterID) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&InstallConfig{},
+	}
+}
+
+// Generate generates the cluster ID. Under reproducible rendering it is
+// derived deterministically from the seed instead of the system's entropy
+// source, so repeated runs against the same install-config.yaml produce
+// the same cluster ID.
+func (a *ClusterID) Generate(dependencies asset.Parents) error {
+	installConfig := &InstallConfig{}
+	dependencies.Get(installConfig)
+
+	seed, ok, err := ResolveSeed(installConfig.Config)
+	if err != nil {
+		return err
+	}
+
+	var id uuid.UUID
+	if ok {
+		id = uuid.NewSHA1(uuid.NameSpaceOID, []byte(seed))
+	} else {
+		generated, err := uuid.NewRandom()
+		if err != nil {
+			return errors.Wrap(err, "failed to generate cluster ID")
+		}
+		id = generated
+	}
+	a.ClusterID = id.String()
+	a.FileList = []*asset.File{{Filename: filepath.Clean(clusterIDPath), Data: []byte(a.ClusterID)}}
+	return nil
+}
+
+// Files returns the files generated by the asset.
+func (a *ClusterID) Files() []*asset.File {
+	return a.FileList
+}
+
+// Load reads the cluster ID from disk, if present.
+func (a *ClusterID) Load(f asset.FileFetcher) (bool, error) {
+	file, err := f.FetchByName(filepath.Clean(clusterIDPath))
+	if err != nil {
+		return false, nil
+	}
+	a.ClusterID, a.FileList = string(file.Data), []*asset.File{file}
+	return true, nil
+}
@@ -0,0 +1,59 @@
+// Package installconfig reads the install-config.yaml file provided by the
+// user and makes it available to the rest of the asset graph.
+package installconfig
+
+import (
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/types"
+)
+
+const installConfigFilename = "install-config.yaml"
+
+// InstallConfig is the asset that holds the install-config.yaml contents.
+type InstallConfig struct {
+	Config   *types.InstallConfig
+	FileList []*asset.File
+}
+
+var _ asset.WritableAsset = (*InstallConfig)(nil)
+
+// Name returns a human friendly name for the asset.
+func (a *InstallConfig) Name() string {
+	return "Install Config"
+}
+
+// Dependencies returns all of the dependencies directly needed by an
+// InstallConfig asset.
+func (a *InstallConfig) Dependencies() []asset.Asset {
+	return []asset.Asset{}
+}
+
+// Generate returns an error; install-config.yaml is always user-supplied,
+// never generated.
+func (a *InstallConfig) Generate(parents asset.Parents) error {
+	return errors.New("install-config.yaml not found; it must be provided")
+}
+
+// Files returns the files generated by the asset.
+func (a *InstallConfig) Files() []*asset.File {
+	return a.FileList
+}
+
+// Load reads install-config.yaml from disk.
+func (a *InstallConfig) Load(f asset.FileFetcher) (bool, error) {
+	file, err := f.FetchByName(installConfigFilename)
+	if err != nil {
+		return false, nil
+	}
+
+	config := &types.InstallConfig{}
+	if err := yaml.Unmarshal(file.Data, config); err != nil {
+		return false, errors.Wrap(err, "failed to unmarshal install-config.yaml")
+	}
+
+	a.Config, a.FileList = config, []*asset.File{file}
+	return true, nil
+}
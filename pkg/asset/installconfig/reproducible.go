@@ -0,0 +1,61 @@
+package installconfig
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/types"
+)
+
+// seedEnvVar overrides install-config.yaml's reproducibleRender.seed when
+// set, so CI can pin a seed without editing the config file.
+const seedEnvVar = "INSTALLER_SEED"
+
+// defaultReproducibleNotBefore is used when reproducibleRender.seed is set
+// but reproducibleRender.notBefore is not.
+var defaultReproducibleNotBefore = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ResolveSeed returns the seed to use for reproducible rendering and
+// whether reproducible rendering is enabled at all. INSTALLER_SEED always
+// takes precedence over install-config.yaml when both are set.
+//
+// Reproducible rendering derives every CA and leaf private key in the
+// cluster from this seed via a non-cryptographic PRNG, so it is refused
+// outright once a real Platform is configured - it may only be used for
+// a platform-less install-config.yaml (dev/CI manifest diffing), never
+// for a real cluster.
+func ResolveSeed(c *types.InstallConfig) (string, bool, error) {
+	seed, enabled := rawSeed(c)
+	if !enabled {
+		return "", false, nil
+	}
+	if platformName := c.Platform.Name(); platformName != "" {
+		return "", false, errors.Errorf("reproducibleRender is not supported on platform %q: it derives all cluster PKI from a non-secret seed and must never be used for a real cluster", platformName)
+	}
+	return seed, true, nil
+}
+
+func rawSeed(c *types.InstallConfig) (string, bool) {
+	if seed := os.Getenv(seedEnvVar); seed != "" {
+		return seed, true
+	}
+	if c.ReproducibleRender != nil && c.ReproducibleRender.Seed != "" {
+		return c.ReproducibleRender.Seed, true
+	}
+	return "", false
+}
+
+// ResolveNotBefore returns the fixed NotBefore time to bake into generated
+// certificates under reproducible rendering.
+func ResolveNotBefore(c *types.InstallConfig) (time.Time, error) {
+	if c.ReproducibleRender == nil || c.ReproducibleRender.NotBefore == "" {
+		return defaultReproducibleNotBefore, nil
+	}
+	notBefore, err := time.Parse(time.RFC3339, c.ReproducibleRender.NotBefore)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to parse reproducibleRender.notBefore")
+	}
+	return notBefore, nil
+}
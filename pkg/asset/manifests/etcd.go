@@ -0,0 +1,134 @@
+package manifests
+
+import (
+	"encoding/base64"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/tls"
+)
+
+const openshiftEtcdNamespace = "openshift-etcd"
+
+type tlsSecretMetadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+type tlsSecretObject struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Type       string            `json:"type"`
+	Metadata   tlsSecretMetadata `json:"metadata"`
+	Data       map[string]string `json:"data"`
+}
+
+// tlsSecretFile renders a cert/key pair as a kubernetes.io/tls Secret in
+// the openshift-etcd namespace.
+func tlsSecretFile(filename, name string, cert, key []byte) (*asset.File, error) {
+	obj := &tlsSecretObject{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Type:       "kubernetes.io/tls",
+		Metadata:   tlsSecretMetadata{Name: name, Namespace: openshiftEtcdNamespace},
+		Data: map[string]string{
+			"tls.crt": base64.StdEncoding.EncodeToString(cert),
+			"tls.key": base64.StdEncoding.EncodeToString(key),
+		},
+	}
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal %s secret", name)
+	}
+	return &asset.File{Filename: filepath.Join(manifestDir, filename), Data: data}, nil
+}
+
+// etcdMemberSecretFiles renders one kubernetes.io/tls Secret per etcd
+// member for the given CertKeys, named "<namePrefix>-<hostname>".
+func etcdMemberSecretFiles(namePrefix string, hostnames []string, certKeys []tls.CertKey) ([]*asset.File, error) {
+	files := make([]*asset.File, 0, len(certKeys))
+	for i, ck := range certKeys {
+		name := fmt.Sprintf("%s-%s", namePrefix, hostnames[i])
+		file, err := tlsSecretFile(fmt.Sprintf("%s-secret.yaml", name), name, ck.Cert(), ck.Key())
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// etcdCABundleConfigmapFile renders the openshift-etcd/etcd-ca-bundle
+// ConfigMap, bundling the etcd-signer and etcd-metric-signer CA certs so
+// operators running in that namespace can trust member-to-member traffic.
+func etcdCABundleConfigmapFile(signerCert, metricSignerCert []byte) (*asset.File, error) {
+	cm := configMap(openshiftEtcdNamespace, "etcd-ca-bundle", genericData{
+		"ca-bundle.crt": string(signerCert) + string(metricSignerCert),
+	})
+	data, err := yaml.Marshal(cm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal etcd-ca-bundle configmap")
+	}
+	return &asset.File{Filename: filepath.Join(manifestDir, "etcd-ca-bundle-configmap.yaml"), Data: data}, nil
+}
+
+// generateEtcdPKIManifests renders the openshift-etcd PKI: a ca-bundle
+// configmap trusted by cluster-etcd-operator, the signer secrets
+// themselves (so the operator can keep minting certs post-install), the
+// shared client cert, and one serving/peer/metrics secret per member.
+// Rendering this at install time lets the etcd operator start from a
+// fully-populated bootstrap state instead of CSR-ing member certs via the
+// kube-client-agent init container at bootstrap.
+func generateEtcdPKIManifests(
+	etcdSignerCA *tls.EtcdSignerCA,
+	etcdMetricSignerCA *tls.EtcdMetricSignerCA,
+	etcdClientCertKey *tls.EtcdClientCertKey,
+	etcdServingCertKey *tls.EtcdServingCertKey,
+	etcdPeerCertKey *tls.EtcdPeerCertKey,
+	etcdMetricCertKey *tls.EtcdMetricCertKey,
+	hostnames []string,
+) ([]*asset.File, error) {
+	files := make([]*asset.File, 0)
+
+	caBundle, err := etcdCABundleConfigmapFile(etcdSignerCA.Cert(), etcdMetricSignerCA.Cert())
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, caBundle)
+
+	signerSecret, err := tlsSecretFile("etcd-signer-secret.yaml", "etcd-signer", etcdSignerCA.Cert(), etcdSignerCA.Key())
+	if err != nil {
+		return nil, err
+	}
+	clientSecret, err := tlsSecretFile("etcd-client-secret.yaml", "etcd-client", etcdClientCertKey.Cert(), etcdClientCertKey.Key())
+	if err != nil {
+		return nil, err
+	}
+	metricSignerSecret, err := tlsSecretFile("etcd-metric-signer-secret.yaml", "etcd-metric-signer", etcdMetricSignerCA.Cert(), etcdMetricSignerCA.Key())
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, signerSecret, clientSecret, metricSignerSecret)
+
+	servingSecrets, err := etcdMemberSecretFiles("etcd-serving", hostnames, etcdServingCertKey.CertKeys)
+	if err != nil {
+		return nil, err
+	}
+	peerSecrets, err := etcdMemberSecretFiles("etcd-peer", hostnames, etcdPeerCertKey.CertKeys)
+	if err != nil {
+		return nil, err
+	}
+	metricSecrets, err := etcdMemberSecretFiles("etcd-serving-metrics", hostnames, etcdMetricCertKey.CertKeys)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, servingSecrets...)
+	files = append(files, peerSecrets...)
+	files = append(files, metricSecrets...)
+
+	return files, nil
+}
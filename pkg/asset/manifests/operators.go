@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/cloudproviderconfig"
 	"github.com/openshift/installer/pkg/asset/installconfig"
 	"github.com/openshift/installer/pkg/asset/templates/content/bootkube"
 	"github.com/openshift/installer/pkg/asset/tls"
@@ -37,6 +38,15 @@ var (
 
 // Manifests generates the dependent operator config.yaml files
 type Manifests struct {
+	// OverlayDir is the directory of operator-supplied overlays merged
+	// onto the rendered manifests. It defaults to defaultOverlayDir when
+	// unset.
+	OverlayDir string
+
+	// OverlayProvenance records which overlay file, if any, produced or
+	// patched each entry in FileList.
+	OverlayProvenance map[string]string
+
 	KubeSysConfig *configurationObject
 	FileList      []*asset.File
 }
@@ -66,6 +76,13 @@ func (m *Manifests) Dependencies() []asset.Asset {
 		&tls.EtcdClientCertKey{},
 		&tls.MCSCertKey{},
 		&tls.KubeletCertKey{},
+		&tls.EtcdSignerCA{},
+		&tls.EtcdMetricSignerCA{},
+		&tls.EtcdServingCertKey{},
+		&tls.EtcdPeerCertKey{},
+		&tls.EtcdMetricCertKey{},
+
+		&cloudproviderconfig.CloudProviderConfig{},
 
 		&bootkube.KubeCloudConfig{},
 		&bootkube.MachineConfigServerTLSSecret{},
@@ -115,6 +132,23 @@ func (m *Manifests) Generate(dependencies asset.Parents) error {
 	m.FileList = append(m.FileList, network.Files()...)
 	m.FileList = append(m.FileList, infra.Files()...)
 
+	overlaid, provenance, err := applyOverlays(m.OverlayDir, m.FileList)
+	if err != nil {
+		return errors.Wrap(err, "failed to apply manifest overlays")
+	}
+	m.FileList, m.OverlayProvenance = overlaid, provenance
+
+	if len(provenance) > 0 {
+		provenanceData, err := yaml.Marshal(provenance)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal overlay provenance")
+		}
+		m.FileList = append(m.FileList, &asset.File{
+			Filename: filepath.Join(manifestDir, overlayProvenancePath),
+			Data:     provenanceData,
+		})
+	}
+
 	asset.SortFiles(m.FileList)
 
 	return nil
@@ -128,21 +162,31 @@ func (m *Manifests) Files() []*asset.File {
 func (m *Manifests) generateBootKubeManifests(dependencies asset.Parents) []*asset.File {
 	clusterID := &installconfig.ClusterID{}
 	installConfig := &installconfig.InstallConfig{}
-	etcdCA := &tls.EtcdCA{}
 	kubeCA := &tls.KubeCA{}
 	mcsCertKey := &tls.MCSCertKey{}
 	etcdClientCertKey := &tls.EtcdClientCertKey{}
 	rootCA := &tls.RootCA{}
 	serviceServingCA := &tls.ServiceServingCA{}
+	etcdSignerCA := &tls.EtcdSignerCA{}
+	etcdMetricSignerCA := &tls.EtcdMetricSignerCA{}
+	etcdServingCertKey := &tls.EtcdServingCertKey{}
+	etcdPeerCertKey := &tls.EtcdPeerCertKey{}
+	etcdMetricCertKey := &tls.EtcdMetricCertKey{}
+	cloudProviderConfig := &cloudproviderconfig.CloudProviderConfig{}
 	dependencies.Get(
 		clusterID,
 		installConfig,
-		etcdCA,
 		etcdClientCertKey,
 		kubeCA,
 		mcsCertKey,
 		rootCA,
 		serviceServingCA,
+		etcdSignerCA,
+		etcdMetricSignerCA,
+		etcdServingCertKey,
+		etcdPeerCertKey,
+		etcdMetricCertKey,
+		cloudProviderConfig,
 	)
 
 	etcdEndpointHostnames := make([]string, installConfig.Config.MasterCount())
@@ -151,8 +195,8 @@ func (m *Manifests) generateBootKubeManifests(dependencies asset.Parents) []*ass
 	}
 
 	templateData := &bootkubeTemplateData{
-		Base64encodeCloudProviderConfig: "", // FIXME
-		EtcdCaCert:                      string(etcdCA.Cert()),
+		Base64encodeCloudProviderConfig: base64.StdEncoding.EncodeToString(cloudProviderConfig.ConfigRaw),
+		EtcdCaCert:                      string(etcdSignerCA.Cert()),
 		EtcdClientCert:                  base64.StdEncoding.EncodeToString(etcdClientCertKey.Cert()),
 		EtcdClientKey:                   base64.StdEncoding.EncodeToString(etcdClientCertKey.Key()),
 		KubeCaCert:                      base64.StdEncoding.EncodeToString(kubeCA.Cert()),
@@ -166,6 +210,7 @@ func (m *Manifests) generateBootKubeManifests(dependencies asset.Parents) []*ass
 		CVOClusterID:                    clusterID.ClusterID,
 		EtcdEndpointHostnames:           etcdEndpointHostnames,
 		EtcdEndpointDNSSuffix:           installConfig.Config.BaseDomain,
+		McsExternalURL:                  mcsExternalURL(installConfig),
 	}
 
 	kubeCloudConfig := &bootkube.KubeCloudConfig{}
@@ -222,6 +267,14 @@ func (m *Manifests) generateBootKubeManifests(dependencies asset.Parents) []*ass
 		})
 	}
 
+	etcdPKIFiles, err := generateEtcdPKIManifests(etcdSignerCA, etcdMetricSignerCA, etcdClientCertKey, etcdServingCertKey, etcdPeerCertKey, etcdMetricCertKey, etcdEndpointHostnames)
+	if err != nil {
+		panic(err)
+	}
+	files = append(files, etcdPKIFiles...)
+
+	files = append(files, cloudProviderConfig.Files()...)
+
 	return files
 }
 
@@ -246,12 +299,19 @@ func (m *Manifests) Load(f asset.FileFetcher) (bool, error) {
 
 	kubeSysConfig := &configurationObject{}
 	var found bool
+	provenancePath := filepath.Join(manifestDir, overlayProvenancePath)
+	provenance := map[string]string{}
 	for _, file := range fileList {
-		if file.Filename == kubeSysConfigPath {
+		switch file.Filename {
+		case kubeSysConfigPath:
 			if err := yaml.Unmarshal(file.Data, kubeSysConfig); err != nil {
 				return false, errors.Wrapf(err, "failed to unmarshal cluster-config.yaml")
 			}
 			found = true
+		case provenancePath:
+			if err := yaml.Unmarshal(file.Data, &provenance); err != nil {
+				return false, errors.Wrapf(err, "failed to unmarshal %s", overlayProvenancePath)
+			}
 		}
 	}
 
@@ -260,7 +320,7 @@ func (m *Manifests) Load(f asset.FileFetcher) (bool, error) {
 
 	}
 
-	m.FileList, m.KubeSysConfig = fileList, kubeSysConfig
+	m.FileList, m.KubeSysConfig, m.OverlayProvenance = fileList, kubeSysConfig, provenance
 
 	asset.SortFiles(m.FileList)
 
@@ -0,0 +1,145 @@
+package manifests
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+)
+
+const (
+	// defaultOverlayDir is used when Manifests.OverlayDir is unset.
+	defaultOverlayDir = "manifests-overlays"
+
+	// patchSuffix marks an overlay file as a strategic-merge patch rather
+	// than a raw replacement/addition.
+	patchSuffix = ".patch.yaml"
+
+	// overlayProvenancePath records, for a rendered manifests/ directory,
+	// which overlay file produced or touched each manifest. It is not
+	// itself a Kubernetes manifest; it exists purely so Load can tell
+	// overlay-sourced content apart from generated content.
+	overlayProvenancePath = ".overlay-provenance.yaml"
+)
+
+// applyOverlays merges operator-supplied overlays from overlayDir onto an
+// already fully template-expanded file list. Two kinds of overlay are
+// supported, selected by filename:
+//
+//   - "<name>.patch.yaml" is a strategic-merge patch: it is decoded as a
+//     generic YAML document and deep-merged onto the existing
+//     manifests/<name>.yaml, with the patch's map keys overriding or
+//     adding to the base's recursively. This is a deliberately simplified
+//     subset of kustomize's strategic-merge semantics: any non-map value,
+//     including lists, replaces the base value wholesale rather than
+//     being merged by patchMergeKey, and there is no "$patch: delete"
+//     support. A patch meant to add one container to an existing list
+//     must repeat the whole list; it cannot append to it.
+//   - any other file is copied verbatim into manifests/, replacing a
+//     file of the same name or adding a new one.
+//
+// It returns the merged file list along with a provenance map of
+// manifest filename to the overlay file that produced it. A missing
+// overlayDir is not an error; it simply means no overlays apply.
+func applyOverlays(overlayDir string, files []*asset.File) ([]*asset.File, map[string]string, error) {
+	if overlayDir == "" {
+		overlayDir = defaultOverlayDir
+	}
+
+	entries, err := ioutil.ReadDir(overlayDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return files, nil, nil
+		}
+		return nil, nil, errors.Wrapf(err, "failed to read overlay directory %q", overlayDir)
+	}
+
+	byName := make(map[string]int, len(files))
+	for i, f := range files {
+		byName[filepath.Base(f.Filename)] = i
+	}
+
+	provenance := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		overlayPath := filepath.Join(overlayDir, entry.Name())
+		data, err := ioutil.ReadFile(overlayPath)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to read overlay %q", overlayPath)
+		}
+
+		if strings.HasSuffix(entry.Name(), patchSuffix) {
+			targetName := strings.TrimSuffix(entry.Name(), patchSuffix) + ".yaml"
+			idx, ok := byName[targetName]
+			if !ok {
+				return nil, nil, errors.Errorf("overlay patch %q has no matching manifest %q to patch", entry.Name(), targetName)
+			}
+			merged, err := strategicMergePatch(files[idx].Data, data)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "failed to apply overlay %q", entry.Name())
+			}
+			files[idx].Data = merged
+			provenance[files[idx].Filename] = entry.Name()
+			continue
+		}
+
+		targetFilename := filepath.Join(manifestDir, entry.Name())
+		if idx, ok := byName[entry.Name()]; ok {
+			files[idx].Data = data
+		} else {
+			files = append(files, &asset.File{Filename: targetFilename, Data: data})
+			byName[entry.Name()] = len(files) - 1
+		}
+		provenance[targetFilename] = entry.Name()
+	}
+
+	return files, provenance, nil
+}
+
+// strategicMergePatch deep-merges patch onto base, both decoded as
+// generic YAML documents.
+func strategicMergePatch(base, patch []byte) ([]byte, error) {
+	var baseDoc, patchDoc map[string]interface{}
+	if err := yaml.Unmarshal(base, &baseDoc); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal base manifest")
+	}
+	if err := yaml.Unmarshal(patch, &patchDoc); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal overlay patch")
+	}
+
+	merged, err := yaml.Marshal(mergeMaps(baseDoc, patchDoc))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal patched manifest")
+	}
+	return merged, nil
+}
+
+// mergeMaps recursively merges patch onto base: matching keys whose
+// values are themselves maps are merged recursively, and any other
+// patch value (including lists) overrides the base value wholesale.
+func mergeMaps(base, patch map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(base)+len(patch))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, patchVal := range patch {
+		if baseVal, ok := out[k]; ok {
+			if baseMap, ok := baseVal.(map[string]interface{}); ok {
+				if patchMap, ok := patchVal.(map[string]interface{}); ok {
+					out[k] = mergeMaps(baseMap, patchMap)
+					continue
+				}
+			}
+		}
+		out[k] = patchVal
+	}
+	return out
+}
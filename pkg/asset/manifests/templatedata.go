@@ -0,0 +1,39 @@
+package manifests
+
+import "github.com/openshift/installer/pkg/asset/installconfig"
+
+// mcsExternalURL returns the URL installer-provisioned nodes should fetch
+// their ignition config from, or the empty string (meaning the in-cluster
+// MCS service) when machineConfigServer.externalURL is unset.
+func mcsExternalURL(installConfig *installconfig.InstallConfig) string {
+	if mcs := installConfig.Config.MachineConfigServer; mcs != nil && mcs.ExternalURL != "" {
+		return mcs.ExternalURL
+	}
+	return ""
+}
+
+// bootkubeTemplateData is the data passed to the bootkube manifest
+// templates rendered by generateBootKubeManifests.
+type bootkubeTemplateData struct {
+	Base64encodeCloudProviderConfig string
+	EtcdCaCert                      string
+	EtcdClientCert                  string
+	EtcdClientKey                   string
+	KubeCaCert                      string
+	KubeCaKey                       string
+	McsTLSCert                      string
+	McsTLSKey                       string
+	PullSecretBase64                string
+	RootCaCert                      string
+	ServiceServingCaCert            string
+	ServiceServingCaKey             string
+	CVOClusterID                    string
+	EtcdEndpointHostnames           []string
+	EtcdEndpointDNSSuffix           string
+
+	// McsExternalURL is where installer-provisioned nodes should fetch
+	// their ignition config from, for UPI/on-prem topologies where nodes
+	// cannot reach the bootstrap node directly. Empty means "use the
+	// in-cluster MCS service as before".
+	McsExternalURL string
+}
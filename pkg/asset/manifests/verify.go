@@ -0,0 +1,21 @@
+package manifests
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashFiles returns a content-addressed hash of the rendered manifests.
+// Under reproducible rendering (install-config.yaml's reproducibleRender
+// stanza, or INSTALLER_SEED), re-rendering the same install-config.yaml
+// produces the same hash; this backs the `manifests --verify` subcommand,
+// which re-renders and compares hashes to confirm reproducibility.
+func (m *Manifests) HashFiles() string {
+	h := sha256.New()
+	for _, f := range m.FileList {
+		h.Write([]byte(f.Filename))
+		h.Write([]byte{0})
+		h.Write(f.Data)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
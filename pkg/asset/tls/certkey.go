@@ -0,0 +1,54 @@
+package tls
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/openshift/installer/pkg/asset"
+)
+
+const (
+	tlsDir = "tls"
+)
+
+// CertKey is a small mixin that holds a generated cert/key pair and knows how
+// to round-trip it to/from the on-disk tls/ directory. Concrete assets embed
+// it and only need to implement Generate.
+type CertKey struct {
+	CertRaw []byte
+	KeyRaw  []byte
+}
+
+// Cert returns the PEM-encoded certificate.
+func (c *CertKey) Cert() []byte {
+	return c.CertRaw
+}
+
+// Key returns the PEM-encoded private key.
+func (c *CertKey) Key() []byte {
+	return c.KeyRaw
+}
+
+// Files returns the cert/key pair as a pair of assets named tls/<name>.crt
+// and tls/<name>.key.
+func (c *CertKey) Files(name string) []*asset.File {
+	return []*asset.File{
+		{Filename: filepath.Join(tlsDir, fmt.Sprintf("%s.crt", name)), Data: c.CertRaw},
+		{Filename: filepath.Join(tlsDir, fmt.Sprintf("%s.key", name)), Data: c.KeyRaw},
+	}
+}
+
+// loadCertKey loads a cert/key pair named tls/<name>.crt and tls/<name>.key
+// from disk, if both are present.
+func loadCertKey(f asset.FileFetcher, c *CertKey, name string) (bool, error) {
+	certFile, err := f.FetchByName(filepath.Join(tlsDir, fmt.Sprintf("%s.crt", name)))
+	if err != nil {
+		return false, nil
+	}
+	keyFile, err := f.FetchByName(filepath.Join(tlsDir, fmt.Sprintf("%s.key", name)))
+	if err != nil {
+		return false, nil
+	}
+	c.CertRaw, c.KeyRaw = certFile.Data, keyFile.Data
+	return true, nil
+}
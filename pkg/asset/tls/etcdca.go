@@ -0,0 +1,62 @@
+package tls
+
+import (
+	"crypto/x509/pkix"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// EtcdCA is the asset that generates the CA used to sign the etcd client
+// certificate presented by the kube-apiserver.
+type EtcdCA struct {
+	CertKey
+}
+
+var _ asset.WritableAsset = (*EtcdCA)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *EtcdCA) Name() string {
+	return "Certificate (etcd-client-ca)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *EtcdCA) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+	}
+}
+
+// Generate generates the cert/key pair.
+func (c *EtcdCA) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	dependencies.Get(installConfig)
+
+	randReader, notBefore, err := reproducibleParams(installConfig, "etcd-client-ca")
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve reproducible rendering params")
+	}
+	cert, key, err := generateSelfSignedCACert(CertCfg{
+		Subject:   pkix.Name{CommonName: "etcd-client-ca", OrganizationalUnit: []string{"etcd"}},
+		Validity:  ValidityTenYears,
+		Rand:      randReader,
+		NotBefore: notBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to generate etcd-client-ca")
+	}
+	c.CertRaw, c.KeyRaw = cert, key
+	return nil
+}
+
+// Load reads the cert/key pair from disk.
+func (c *EtcdCA) Load(f asset.FileFetcher) (bool, error) {
+	return loadCertKey(f, &c.CertKey, "etcd-client-ca")
+}
+
+// Files returns the files generated by the asset.
+func (c *EtcdCA) Files() []*asset.File {
+	return c.CertKey.Files("etcd-client-ca")
+}
@@ -0,0 +1,67 @@
+package tls
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// EtcdClientCertKey is the asset that generates the client certificate the
+// kube-apiserver uses to talk to etcd, signed by EtcdCA.
+type EtcdClientCertKey struct {
+	CertKey
+}
+
+var _ asset.WritableAsset = (*EtcdClientCertKey)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *EtcdClientCertKey) Name() string {
+	return "Certificate (etcd-client)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *EtcdClientCertKey) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+		&EtcdCA{},
+	}
+}
+
+// Generate generates the cert/key pair.
+func (c *EtcdClientCertKey) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	etcdCA := &EtcdCA{}
+	dependencies.Get(installConfig, etcdCA)
+
+	randReader, notBefore, err := reproducibleParams(installConfig, "etcd-client")
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve reproducible rendering params")
+	}
+	cert, key, err := generateSignedCertKey(etcdCA.Cert(), etcdCA.Key(), CertCfg{
+		Subject:      pkix.Name{CommonName: "etcd-client"},
+		KeyUsages:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		Validity:     ValidityTenYears,
+		Rand:         randReader,
+		NotBefore:    notBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to generate etcd-client cert/key pair")
+	}
+	c.CertRaw, c.KeyRaw = cert, key
+	return nil
+}
+
+// Load reads the cert/key pair from disk.
+func (c *EtcdClientCertKey) Load(f asset.FileFetcher) (bool, error) {
+	return loadCertKey(f, &c.CertKey, "etcd-client")
+}
+
+// Files returns the files generated by the asset.
+func (c *EtcdClientCertKey) Files() []*asset.File {
+	return c.CertKey.Files("etcd-client")
+}
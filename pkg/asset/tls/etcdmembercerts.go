@@ -0,0 +1,85 @@
+package tls
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"net"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+	"github.com/openshift/installer/pkg/types"
+)
+
+// etcdMemberCertKeyInstallConfigFilename is read independently by
+// loadEtcdMemberCertKeys, which runs outside the asset graph and so
+// cannot depend on installconfig.InstallConfig to learn MasterCount.
+const etcdMemberCertKeyInstallConfigFilename = "install-config.yaml"
+
+// generateEtcdMemberCertKeys issues one leaf cert/key pair per etcd member,
+// signed by the given CA, with SANs covering the member hostname under
+// BaseDomain, localhost, and the bootstrap node (which also runs a
+// temporary etcd member until it is scaled down).
+func generateEtcdMemberCertKeys(installConfig *installconfig.InstallConfig, caCert, caKey []byte, cnPrefix string, extKeyUsages []x509.ExtKeyUsage) ([]CertKey, error) {
+	clusterName := installConfig.Config.ObjectMeta.Name
+	baseDomain := installConfig.Config.BaseDomain
+	bootstrapHostname := fmt.Sprintf("%s-bootstrap.%s", clusterName, baseDomain)
+
+	certKeys := make([]CertKey, installConfig.Config.MasterCount())
+	for i := range certKeys {
+		hostname := fmt.Sprintf("%s-etcd-%d.%s", clusterName, i, baseDomain)
+		randReader, notBefore, err := reproducibleParams(installConfig, fmt.Sprintf("%s%d", cnPrefix, i))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve reproducible rendering params for etcd member %s", hostname)
+		}
+		cert, key, err := generateSignedCertKey(caCert, caKey, CertCfg{
+			Subject:      pkix.Name{CommonName: fmt.Sprintf("%s%s", cnPrefix, hostname)},
+			KeyUsages:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			ExtKeyUsages: extKeyUsages,
+			Validity:     ValidityTenYears,
+			DNSNames:     []string{hostname, "localhost", bootstrapHostname},
+			IPAddresses:  []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")},
+			Rand:         randReader,
+			NotBefore:    notBefore,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to generate cert/key for etcd member %s", hostname)
+		}
+		certKeys[i] = CertKey{CertRaw: cert, KeyRaw: key}
+	}
+	return certKeys, nil
+}
+
+// loadEtcdMemberCertKeys loads one cert/key pair per etcd member from disk,
+// named tls/<fmt.Sprintf(nameFmt, i)>.crt and .key. The member count comes
+// from re-reading install-config.yaml directly, since Load runs outside the
+// asset graph and has no installconfig.InstallConfig dependency to consult.
+// It returns false if install-config.yaml or any expected member cert/key
+// pair is missing, so the caller regenerates instead of loading a partial
+// set.
+func loadEtcdMemberCertKeys(f asset.FileFetcher, nameFmt string) ([]CertKey, bool, error) {
+	icFile, err := f.FetchByName(etcdMemberCertKeyInstallConfigFilename)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	config := &types.InstallConfig{}
+	if err := yaml.Unmarshal(icFile.Data, config); err != nil {
+		return nil, false, errors.Wrap(err, "failed to unmarshal install-config.yaml")
+	}
+
+	certKeys := make([]CertKey, config.MasterCount())
+	for i := range certKeys {
+		ok, err := loadCertKey(f, &certKeys[i], fmt.Sprintf(nameFmt, i))
+		if err != nil {
+			return nil, false, err
+		}
+		if !ok {
+			return nil, false, nil
+		}
+	}
+	return certKeys, true, nil
+}
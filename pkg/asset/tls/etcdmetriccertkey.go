@@ -0,0 +1,77 @@
+package tls
+
+import (
+	"crypto/x509"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// EtcdMetricCertKey is the asset that generates the etcd member metrics
+// serving certificates, one per master, signed by EtcdMetricSignerCA.
+type EtcdMetricCertKey struct {
+	CertKeys []CertKey
+}
+
+var _ asset.WritableAsset = (*EtcdMetricCertKey)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *EtcdMetricCertKey) Name() string {
+	return "Certificate (etcd-metric)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *EtcdMetricCertKey) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+		&EtcdMetricSignerCA{},
+	}
+}
+
+// Generate generates the cert/key pairs.
+func (c *EtcdMetricCertKey) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	etcdMetricSignerCA := &EtcdMetricSignerCA{}
+	dependencies.Get(installConfig, etcdMetricSignerCA)
+
+	certKeys, err := generateEtcdMemberCertKeys(
+		installConfig,
+		etcdMetricSignerCA.Cert(), etcdMetricSignerCA.Key(),
+		"system:etcd-metric:",
+		[]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate etcd-metric cert/key pairs")
+	}
+	c.CertKeys = certKeys
+	return nil
+}
+
+// Load reads the etcd-metric cert/key pairs back from disk, one per
+// master, falling back to regeneration from EtcdMetricSignerCA if any
+// are missing.
+func (c *EtcdMetricCertKey) Load(f asset.FileFetcher) (bool, error) {
+	certKeys, ok, err := loadEtcdMemberCertKeys(f, "etcd-serving-metrics-%d")
+	if err != nil || !ok {
+		return false, err
+	}
+	c.CertKeys = certKeys
+	return true, nil
+}
+
+// Files returns the files generated by the asset, one cert/key pair per
+// etcd member.
+func (c *EtcdMetricCertKey) Files() []*asset.File {
+	files := make([]*asset.File, 0, len(c.CertKeys)*2)
+	for i, ck := range c.CertKeys {
+		files = append(files,
+			&asset.File{Filename: filepath.Join(tlsDir, fmt.Sprintf("etcd-serving-metrics-%d.crt", i)), Data: ck.Cert()},
+			&asset.File{Filename: filepath.Join(tlsDir, fmt.Sprintf("etcd-serving-metrics-%d.key", i)), Data: ck.Key()},
+		)
+	}
+	return files
+}
@@ -0,0 +1,77 @@
+package tls
+
+import (
+	"crypto/x509"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// EtcdPeerCertKey is the asset that generates the etcd member peer
+// certificates, one per master, signed by EtcdSignerCA.
+type EtcdPeerCertKey struct {
+	CertKeys []CertKey
+}
+
+var _ asset.WritableAsset = (*EtcdPeerCertKey)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *EtcdPeerCertKey) Name() string {
+	return "Certificate (etcd-peer)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *EtcdPeerCertKey) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+		&EtcdSignerCA{},
+	}
+}
+
+// Generate generates the cert/key pairs.
+func (c *EtcdPeerCertKey) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	etcdSignerCA := &EtcdSignerCA{}
+	dependencies.Get(installConfig, etcdSignerCA)
+
+	certKeys, err := generateEtcdMemberCertKeys(
+		installConfig,
+		etcdSignerCA.Cert(), etcdSignerCA.Key(),
+		"system:etcd-peer:",
+		[]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate etcd-peer cert/key pairs")
+	}
+	c.CertKeys = certKeys
+	return nil
+}
+
+// Load reads the etcd-peer cert/key pairs back from disk, one per
+// master, falling back to regeneration from EtcdSignerCA if any are
+// missing.
+func (c *EtcdPeerCertKey) Load(f asset.FileFetcher) (bool, error) {
+	certKeys, ok, err := loadEtcdMemberCertKeys(f, "etcd-peer-%d")
+	if err != nil || !ok {
+		return false, err
+	}
+	c.CertKeys = certKeys
+	return true, nil
+}
+
+// Files returns the files generated by the asset, one cert/key pair per
+// etcd member.
+func (c *EtcdPeerCertKey) Files() []*asset.File {
+	files := make([]*asset.File, 0, len(c.CertKeys)*2)
+	for i, ck := range c.CertKeys {
+		files = append(files,
+			&asset.File{Filename: filepath.Join(tlsDir, fmt.Sprintf("etcd-peer-%d.crt", i)), Data: ck.Cert()},
+			&asset.File{Filename: filepath.Join(tlsDir, fmt.Sprintf("etcd-peer-%d.key", i)), Data: ck.Key()},
+		)
+	}
+	return files
+}
@@ -0,0 +1,77 @@
+package tls
+
+import (
+	"crypto/x509"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// EtcdServingCertKey is the asset that generates the etcd member serving
+// certificates, one per master, signed by EtcdSignerCA.
+type EtcdServingCertKey struct {
+	CertKeys []CertKey
+}
+
+var _ asset.WritableAsset = (*EtcdServingCertKey)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *EtcdServingCertKey) Name() string {
+	return "Certificate (etcd-serving)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *EtcdServingCertKey) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+		&EtcdSignerCA{},
+	}
+}
+
+// Generate generates the cert/key pairs.
+func (c *EtcdServingCertKey) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	etcdSignerCA := &EtcdSignerCA{}
+	dependencies.Get(installConfig, etcdSignerCA)
+
+	certKeys, err := generateEtcdMemberCertKeys(
+		installConfig,
+		etcdSignerCA.Cert(), etcdSignerCA.Key(),
+		"system:etcd-server:",
+		[]x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate etcd-serving cert/key pairs")
+	}
+	c.CertKeys = certKeys
+	return nil
+}
+
+// Load reads the etcd-serving cert/key pairs back from disk, one per
+// master, falling back to regeneration from EtcdSignerCA if any are
+// missing.
+func (c *EtcdServingCertKey) Load(f asset.FileFetcher) (bool, error) {
+	certKeys, ok, err := loadEtcdMemberCertKeys(f, "etcd-serving-%d")
+	if err != nil || !ok {
+		return false, err
+	}
+	c.CertKeys = certKeys
+	return true, nil
+}
+
+// Files returns the files generated by the asset, one cert/key pair per
+// etcd member.
+func (c *EtcdServingCertKey) Files() []*asset.File {
+	files := make([]*asset.File, 0, len(c.CertKeys)*2)
+	for i, ck := range c.CertKeys {
+		files = append(files,
+			&asset.File{Filename: filepath.Join(tlsDir, fmt.Sprintf("etcd-serving-%d.crt", i)), Data: ck.Cert()},
+			&asset.File{Filename: filepath.Join(tlsDir, fmt.Sprintf("etcd-serving-%d.key", i)), Data: ck.Key()},
+		)
+	}
+	return files
+}
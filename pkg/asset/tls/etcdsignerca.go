@@ -0,0 +1,73 @@
+package tls
+
+import (
+	"crypto/x509/pkix"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// EtcdSignerCA is the asset that generates the etcd CA used to sign the
+// per-member serving and peer certificates.
+type EtcdSignerCA struct {
+	CertKey
+}
+
+var _ asset.WritableAsset = (*EtcdSignerCA)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *EtcdSignerCA) Name() string {
+	return "Certificate (etcd-signer)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *EtcdSignerCA) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+	}
+}
+
+// Generate generates the cert/key pair, using the install config's
+// pki.etcdSignerCA pair as-is when supplied instead of self-signing a
+// fresh one.
+func (c *EtcdSignerCA) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	dependencies.Get(installConfig)
+
+	if pki := installConfig.Config.PKI; pki != nil && pki.EtcdSignerCA != nil {
+		cert, key, err := loadExternalCA(pki.EtcdSignerCA)
+		if err != nil {
+			return errors.Wrap(err, "failed to load external etcd-signer CA")
+		}
+		c.CertRaw, c.KeyRaw = cert, key
+		return nil
+	}
+
+	randReader, notBefore, err := reproducibleParams(installConfig, "etcd-signer")
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve reproducible rendering params")
+	}
+	cert, key, err := generateSelfSignedCACert(CertCfg{
+		Subject:   pkix.Name{CommonName: "etcd-signer", OrganizationalUnit: []string{"etcd"}},
+		Validity:  ValidityTenYears,
+		Rand:      randReader,
+		NotBefore: notBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to generate etcd-signer CA")
+	}
+	c.CertRaw, c.KeyRaw = cert, key
+	return nil
+}
+
+// Load reads the cert/key pair from disk.
+func (c *EtcdSignerCA) Load(f asset.FileFetcher) (bool, error) {
+	return loadCertKey(f, &c.CertKey, "etcd-signer")
+}
+
+// Files returns the files generated by the asset.
+func (c *EtcdSignerCA) Files() []*asset.File {
+	return c.CertKey.Files("etcd-signer")
+}
@@ -0,0 +1,69 @@
+package tls
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// IngressCertKey is the asset that generates the wildcard serving
+// certificate for the default ingress router, signed by RootCA.
+type IngressCertKey struct {
+	CertKey
+}
+
+var _ asset.WritableAsset = (*IngressCertKey)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *IngressCertKey) Name() string {
+	return "Certificate (ingress)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *IngressCertKey) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+		&RootCA{},
+	}
+}
+
+// Generate generates the cert/key pair.
+func (c *IngressCertKey) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	rootCA := &RootCA{}
+	dependencies.Get(installConfig, rootCA)
+
+	wildcard := "*.apps." + installConfig.Config.BaseDomain
+	randReader, notBefore, err := reproducibleParams(installConfig, "ingress")
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve reproducible rendering params")
+	}
+	cert, key, err := generateSignedCertKey(rootCA.Cert(), rootCA.Key(), CertCfg{
+		Subject:      pkix.Name{CommonName: wildcard},
+		KeyUsages:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		Validity:     ValidityTenYears,
+		DNSNames:     []string{wildcard},
+		Rand:         randReader,
+		NotBefore:    notBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to generate ingress cert/key pair")
+	}
+	c.CertRaw, c.KeyRaw = cert, key
+	return nil
+}
+
+// Load reads the cert/key pair from disk.
+func (c *IngressCertKey) Load(f asset.FileFetcher) (bool, error) {
+	return loadCertKey(f, &c.CertKey, "ingress")
+}
+
+// Files returns the files generated by the asset.
+func (c *IngressCertKey) Files() []*asset.File {
+	return c.CertKey.Files("ingress")
+}
@@ -0,0 +1,72 @@
+package tls
+
+import (
+	"crypto/x509/pkix"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// KubeCA is the asset that generates the kube-ca, used to sign
+// kube-apiserver client certificates such as the kubelet's.
+type KubeCA struct {
+	CertKey
+}
+
+var _ asset.WritableAsset = (*KubeCA)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *KubeCA) Name() string {
+	return "Certificate (kube-ca)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *KubeCA) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+	}
+}
+
+// Generate generates the cert/key pair, using the install config's
+// pki.kubeCA pair as-is when supplied instead of self-signing a fresh one.
+func (c *KubeCA) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	dependencies.Get(installConfig)
+
+	if pki := installConfig.Config.PKI; pki != nil && pki.KubeCA != nil {
+		cert, key, err := loadExternalCA(pki.KubeCA)
+		if err != nil {
+			return errors.Wrap(err, "failed to load external kube-ca")
+		}
+		c.CertRaw, c.KeyRaw = cert, key
+		return nil
+	}
+
+	randReader, notBefore, err := reproducibleParams(installConfig, "kube-ca")
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve reproducible rendering params")
+	}
+	cert, key, err := generateSelfSignedCACert(CertCfg{
+		Subject:   pkix.Name{CommonName: "kube-ca", OrganizationalUnit: []string{"openshift"}},
+		Validity:  ValidityTenYears,
+		Rand:      randReader,
+		NotBefore: notBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to generate kube-ca")
+	}
+	c.CertRaw, c.KeyRaw = cert, key
+	return nil
+}
+
+// Load reads the cert/key pair from disk.
+func (c *KubeCA) Load(f asset.FileFetcher) (bool, error) {
+	return loadCertKey(f, &c.CertKey, "kube-ca")
+}
+
+// Files returns the files generated by the asset.
+func (c *KubeCA) Files() []*asset.File {
+	return c.CertKey.Files("kube-ca")
+}
@@ -0,0 +1,68 @@
+package tls
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// KubeletCertKey is the asset that generates the client certificate the
+// bootstrap kubelet uses to authenticate to the kube-apiserver, signed by
+// KubeCA.
+type KubeletCertKey struct {
+	CertKey
+}
+
+var _ asset.WritableAsset = (*KubeletCertKey)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *KubeletCertKey) Name() string {
+	return "Certificate (kubelet)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *KubeletCertKey) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+		&KubeCA{},
+	}
+}
+
+// Generate generates the cert/key pair.
+func (c *KubeletCertKey) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	kubeCA := &KubeCA{}
+	dependencies.Get(installConfig, kubeCA)
+
+	randReader, notBefore, err := reproducibleParams(installConfig, "kubelet")
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve reproducible rendering params")
+	}
+	cert, key, err := generateSignedCertKey(kubeCA.Cert(), kubeCA.Key(), CertCfg{
+		Subject:      pkix.Name{CommonName: "system:serviceaccount:kube-system:default", Organization: []string{"system:serviceaccounts:kube-system"}},
+		KeyUsages:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		Validity:     ValidityTenYears,
+		Rand:         randReader,
+		NotBefore:    notBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to generate kubelet cert/key pair")
+	}
+	c.CertRaw, c.KeyRaw = cert, key
+	return nil
+}
+
+// Load reads the cert/key pair from disk.
+func (c *KubeletCertKey) Load(f asset.FileFetcher) (bool, error) {
+	return loadCertKey(f, &c.CertKey, "kubelet")
+}
+
+// Files returns the files generated by the asset.
+func (c *KubeletCertKey) Files() []*asset.File {
+	return c.CertKey.Files("kubelet")
+}
@@ -0,0 +1,101 @@
+package tls
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// MCSCertKey is the asset that generates the certificate the
+// machine-config-server uses to serve ignition configs over :22623,
+// signed by RootCA unless the install config's pki.machineConfigServerCA
+// supplies an external signer. Its SANs cover the in-cluster MCS hostname
+// plus whatever machineConfigServer.servingCertSANs the install config
+// adds for a front-side LB or on-prem VIP.
+type MCSCertKey struct {
+	CertKey
+}
+
+var _ asset.WritableAsset = (*MCSCertKey)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *MCSCertKey) Name() string {
+	return "Certificate (mcs)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *MCSCertKey) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+		&RootCA{},
+	}
+}
+
+// Generate generates the cert/key pair.
+func (c *MCSCertKey) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	rootCA := &RootCA{}
+	dependencies.Get(installConfig, rootCA)
+
+	caCert, caKey := rootCA.Cert(), rootCA.Key()
+	if pki := installConfig.Config.PKI; pki != nil && pki.MachineConfigServerCA != nil {
+		externalCert, externalKey, err := loadExternalCA(pki.MachineConfigServerCA)
+		if err != nil {
+			return errors.Wrap(err, "failed to load external machine-config-server CA")
+		}
+		caCert, caKey = externalCert, externalKey
+	}
+
+	clusterName := installConfig.Config.ObjectMeta.Name
+	baseDomain := installConfig.Config.BaseDomain
+	dnsNames := []string{
+		"localhost",
+		clusterName + "-mcs." + baseDomain,
+		"api." + baseDomain,
+	}
+	ipAddresses := []net.IP{net.ParseIP("127.0.0.1")}
+	if mcs := installConfig.Config.MachineConfigServer; mcs != nil {
+		for _, san := range mcs.ServingCertSANs {
+			if ip := net.ParseIP(san); ip != nil {
+				ipAddresses = append(ipAddresses, ip)
+			} else {
+				dnsNames = append(dnsNames, san)
+			}
+		}
+	}
+
+	randReader, notBefore, err := reproducibleParams(installConfig, "mcs")
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve reproducible rendering params")
+	}
+	cert, key, err := generateSignedCertKey(caCert, caKey, CertCfg{
+		Subject:      pkix.Name{CommonName: "system:mcs"},
+		KeyUsages:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		Validity:     ValidityTenYears,
+		DNSNames:     dnsNames,
+		IPAddresses:  ipAddresses,
+		Rand:         randReader,
+		NotBefore:    notBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to generate mcs cert/key pair")
+	}
+	c.CertRaw, c.KeyRaw = cert, key
+	return nil
+}
+
+// Load reads the cert/key pair from disk.
+func (c *MCSCertKey) Load(f asset.FileFetcher) (bool, error) {
+	return loadCertKey(f, &c.CertKey, "mcs")
+}
+
+// Files returns the files generated by the asset.
+func (c *MCSCertKey) Files() []*asset.File {
+	return c.CertKey.Files("mcs")
+}
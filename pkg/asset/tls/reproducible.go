@@ -0,0 +1,36 @@
+package tls
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"time"
+
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// reproducibleParams returns the deterministic rand source and fixed
+// NotBefore to use when generating a cert/key pair under reproducible
+// rendering, salted by label so distinct assets (e.g. each etcd member)
+// don't collide on the same key material. It returns a nil reader and a
+// zero time when reproducible rendering is not enabled, which tells the
+// generation helpers to fall back to crypto/rand and time.Now. It returns
+// an error when reproducible rendering is requested but not permitted for
+// the configured platform - see installconfig.ResolveSeed.
+func reproducibleParams(installConfig *installconfig.InstallConfig, label string) (io.Reader, time.Time, error) {
+	seed, ok, err := installconfig.ResolveSeed(installConfig.Config)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if !ok {
+		return nil, time.Time{}, nil
+	}
+	notBefore, err := installconfig.ResolveNotBefore(installConfig.Config)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	digest := sha256.Sum256([]byte(seed + ":" + label))
+	seedInt := int64(binary.BigEndian.Uint64(digest[:8]))
+	return rand.New(rand.NewSource(seedInt)), notBefore, nil
+}
@@ -0,0 +1,72 @@
+package tls
+
+import (
+	"crypto/x509/pkix"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// RootCA is the asset that generates the root-ca, the cluster's overall
+// root of trust.
+type RootCA struct {
+	CertKey
+}
+
+var _ asset.WritableAsset = (*RootCA)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *RootCA) Name() string {
+	return "Certificate (root-ca)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *RootCA) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+	}
+}
+
+// Generate generates the cert/key pair, using the install config's
+// pki.rootCA pair as-is when supplied instead of self-signing a fresh one.
+func (c *RootCA) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	dependencies.Get(installConfig)
+
+	if pki := installConfig.Config.PKI; pki != nil && pki.RootCA != nil {
+		cert, key, err := loadExternalCA(pki.RootCA)
+		if err != nil {
+			return errors.Wrap(err, "failed to load external root-ca")
+		}
+		c.CertRaw, c.KeyRaw = cert, key
+		return nil
+	}
+
+	randReader, notBefore, err := reproducibleParams(installConfig, "root-ca")
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve reproducible rendering params")
+	}
+	cert, key, err := generateSelfSignedCACert(CertCfg{
+		Subject:   pkix.Name{CommonName: "root-ca", OrganizationalUnit: []string{"openshift"}},
+		Validity:  ValidityTenYears,
+		Rand:      randReader,
+		NotBefore: notBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to generate root-ca")
+	}
+	c.CertRaw, c.KeyRaw = cert, key
+	return nil
+}
+
+// Load reads the cert/key pair from disk.
+func (c *RootCA) Load(f asset.FileFetcher) (bool, error) {
+	return loadCertKey(f, &c.CertKey, "root-ca")
+}
+
+// Files returns the files generated by the asset.
+func (c *RootCA) Files() []*asset.File {
+	return c.CertKey.Files("root-ca")
+}
@@ -0,0 +1,73 @@
+package tls
+
+import (
+	"crypto/x509/pkix"
+
+	"github.com/pkg/errors"
+
+	"github.com/openshift/installer/pkg/asset"
+	"github.com/openshift/installer/pkg/asset/installconfig"
+)
+
+// ServiceServingCA is the asset that generates the CA used by the
+// service-ca operator to mint per-service serving certificates.
+type ServiceServingCA struct {
+	CertKey
+}
+
+var _ asset.WritableAsset = (*ServiceServingCA)(nil)
+
+// Name returns a human friendly name for the asset.
+func (c *ServiceServingCA) Name() string {
+	return "Certificate (service-serving-ca)"
+}
+
+// Dependencies returns the dependencies of this asset.
+func (c *ServiceServingCA) Dependencies() []asset.Asset {
+	return []asset.Asset{
+		&installconfig.InstallConfig{},
+	}
+}
+
+// Generate generates the cert/key pair, using the install config's
+// pki.serviceServingCA pair as-is when supplied instead of self-signing a
+// fresh one.
+func (c *ServiceServingCA) Generate(dependencies asset.Parents) error {
+	installConfig := &installconfig.InstallConfig{}
+	dependencies.Get(installConfig)
+
+	if pki := installConfig.Config.PKI; pki != nil && pki.ServiceServingCA != nil {
+		cert, key, err := loadExternalCA(pki.ServiceServingCA)
+		if err != nil {
+			return errors.Wrap(err, "failed to load external service-serving-ca")
+		}
+		c.CertRaw, c.KeyRaw = cert, key
+		return nil
+	}
+
+	randReader, notBefore, err := reproducibleParams(installConfig, "service-serving-ca")
+	if err != nil {
+		return errors.Wrap(err, "failed to resolve reproducible rendering params")
+	}
+	cert, key, err := generateSelfSignedCACert(CertCfg{
+		Subject:   pkix.Name{CommonName: "service-serving-ca", OrganizationalUnit: []string{"openshift"}},
+		Validity:  ValidityTenYears,
+		Rand:      randReader,
+		NotBefore: notBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to generate service-serving-ca")
+	}
+	c.CertRaw, c.KeyRaw = cert, key
+	return nil
+}
+
+// Load reads the cert/key pair from disk.
+func (c *ServiceServingCA) Load(f asset.FileFetcher) (bool, error) {
+	return loadCertKey(f, &c.CertKey, "service-serving-ca")
+}
+
+// Files returns the files generated by the asset.
+func (c *ServiceServingCA) Files() []*asset.File {
+	return c.CertKey.Files("service-serving-ca")
+}
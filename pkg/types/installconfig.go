@@ -0,0 +1,46 @@
+package types
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InstallConfig is the configuration for an OpenShift install.
+type InstallConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	// BaseDomain is the base domain to which the cluster should belong.
+	BaseDomain string `json:"baseDomain"`
+
+	// PullSecret is the secret to use when pulling images.
+	PullSecret string `json:"pullSecret"`
+
+	// Platform is the configuration for the specific platform upon which to
+	// perform the installation.
+	Platform Platform `json:"platform"`
+
+	// ControlPlane is the configuration for the machines that comprise the
+	// control plane.
+	ControlPlane *MachinePool `json:"controlPlane,omitempty"`
+
+	// PKI optionally supplies pre-existing signer CAs so the installer
+	// does not have to generate its own. See types.PKI for details.
+	PKI *PKI `json:"pki,omitempty"`
+
+	// MachineConfigServer configures how the machine-config-server serves
+	// ignition configs to provisioning nodes.
+	MachineConfigServer *MachineConfigServer `json:"machineConfigServer,omitempty"`
+
+	// ReproducibleRender, when set, makes manifest rendering
+	// deterministic. See types.ReproducibleRender for details.
+	ReproducibleRender *ReproducibleRender `json:"reproducibleRender,omitempty"`
+}
+
+// MasterCount returns the number of control plane replicas specified by the
+// install config, defaulting to three when unset.
+func (c *InstallConfig) MasterCount() int {
+	if c.ControlPlane != nil && c.ControlPlane.Replicas != nil {
+		return int(*c.ControlPlane.Replicas)
+	}
+	return 3
+}
@@ -0,0 +1,17 @@
+package types
+
+// MachineConfigServer configures how the machine-config-server serves
+// ignition configs to provisioning nodes.
+type MachineConfigServer struct {
+	// ServingCertSANs lists extra DNS names and/or IP addresses to include
+	// on the server's serving certificate, in addition to the bootstrap
+	// node and localhost. Set this when a front-side load balancer or
+	// on-prem VIP terminates :22623 on behalf of the bootstrap node.
+	ServingCertSANs []string `json:"servingCertSANs,omitempty"`
+
+	// ExternalURL is the URL installer-provisioned nodes should fetch
+	// their ignition config from, instead of reaching the bootstrap node
+	// directly. Required for UPI/on-prem topologies where nodes cannot
+	// route to the bootstrap node.
+	ExternalURL string `json:"externalURL,omitempty"`
+}
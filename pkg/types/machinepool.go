@@ -0,0 +1,10 @@
+package types
+
+// MachinePool is a pool of machines to be installed.
+type MachinePool struct {
+	// Name is the name of the machine pool.
+	Name string `json:"name,omitempty"`
+
+	// Replicas is the number of machines to provision for the pool.
+	Replicas *int64 `json:"replicas,omitempty"`
+}
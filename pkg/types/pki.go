@@ -0,0 +1,37 @@
+package types
+
+// PKI lets an operator hand the installer pre-existing signer
+// certificate authorities instead of having fresh ones generated for the
+// cluster. Any CA left unset falls back to the installer's usual
+// self-signed generation. This unblocks air-gapped installs where an
+// org's HSM-backed CA must sign the cluster's PKI.
+type PKI struct {
+	// RootCA is used as the cluster's overall root-of-trust CA
+	// (kube-system/root-ca) in place of a generated one.
+	RootCA *CertKeyPath `json:"rootCA,omitempty"`
+
+	// KubeCA is the CA used to sign kubelet and other internal
+	// kube-apiserver client certificates.
+	KubeCA *CertKeyPath `json:"kubeCA,omitempty"`
+
+	// EtcdSignerCA is the CA used to sign etcd member serving and peer
+	// certificates.
+	EtcdSignerCA *CertKeyPath `json:"etcdSignerCA,omitempty"`
+
+	// ServiceServingCA is the CA used to sign service serving
+	// certificates minted by the service-ca operator.
+	ServiceServingCA *CertKeyPath `json:"serviceServingCA,omitempty"`
+
+	// MachineConfigServerCA is the CA used to sign the machine-config
+	// server's ignition-serving certificate.
+	MachineConfigServerCA *CertKeyPath `json:"machineConfigServerCA,omitempty"`
+}
+
+// CertKeyPath references a PEM-encoded certificate and key pair on disk.
+type CertKeyPath struct {
+	// CertFile is the path to the PEM-encoded certificate.
+	CertFile string `json:"certFile"`
+
+	// KeyFile is the path to the PEM-encoded private key.
+	KeyFile string `json:"keyFile"`
+}
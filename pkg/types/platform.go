@@ -0,0 +1,30 @@
+package types
+
+// Platform stores the configuration for the specific platform upon which to
+// perform the installation. Exactly one of its fields should be set.
+type Platform struct {
+	AWS       *AWSPlatform       `json:"aws,omitempty"`
+	Azure     *AzurePlatform     `json:"azure,omitempty"`
+	GCP       *GCPPlatform       `json:"gcp,omitempty"`
+	OpenStack *OpenStackPlatform `json:"openstack,omitempty"`
+	VSphere   *VSpherePlatform   `json:"vsphere,omitempty"`
+}
+
+// Name returns a short identifier for the platform that is set, or the
+// empty string if none is.
+func (p *Platform) Name() string {
+	switch {
+	case p.AWS != nil:
+		return "aws"
+	case p.Azure != nil:
+		return "azure"
+	case p.GCP != nil:
+		return "gcp"
+	case p.OpenStack != nil:
+		return "openstack"
+	case p.VSphere != nil:
+		return "vsphere"
+	default:
+		return ""
+	}
+}
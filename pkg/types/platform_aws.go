@@ -0,0 +1,7 @@
+package types
+
+// AWSPlatform stores the configuration for the AWS platform.
+type AWSPlatform struct {
+	// Region is the AWS region to deploy the cluster into.
+	Region string `json:"region"`
+}
@@ -0,0 +1,19 @@
+package types
+
+// AzurePlatform stores the configuration for the Azure platform.
+type AzurePlatform struct {
+	// Region is the Azure region to deploy the cluster into.
+	Region string `json:"region"`
+
+	// TenantID is the ID of the Azure Active Directory tenant that owns
+	// the subscription the cluster is deployed into.
+	TenantID string `json:"tenantID"`
+
+	// SubscriptionID is the ID of the Azure subscription to deploy the
+	// cluster into.
+	SubscriptionID string `json:"subscriptionID"`
+
+	// ResourceGroup is the resource group the cluster's resources are
+	// created in.
+	ResourceGroup string `json:"resourceGroup"`
+}
@@ -0,0 +1,10 @@
+package types
+
+// GCPPlatform stores the configuration for the GCP platform.
+type GCPPlatform struct {
+	// ProjectID is the ID of the GCP project to deploy the cluster into.
+	ProjectID string `json:"projectID"`
+
+	// Region is the GCP region to deploy the cluster into.
+	Region string `json:"region"`
+}
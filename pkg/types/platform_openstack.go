@@ -0,0 +1,12 @@
+package types
+
+// OpenStackPlatform stores the configuration for the OpenStack platform.
+type OpenStackPlatform struct {
+	// Cloud is the name of the entry in clouds.yaml to use for
+	// authentication.
+	Cloud string `json:"cloud"`
+
+	// ExternalNetwork is the external Neutron network to attach the
+	// cluster's load balancers to.
+	ExternalNetwork string `json:"externalNetwork"`
+}
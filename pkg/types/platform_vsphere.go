@@ -0,0 +1,18 @@
+package types
+
+// VSpherePlatform stores the configuration for the vSphere platform.
+type VSpherePlatform struct {
+	// VCenter is the domain name or IP address of the vCenter.
+	VCenter string `json:"vCenter"`
+
+	// Username and Password authenticate against VCenter.
+	Username string `json:"username"`
+	Password string `json:"password"`
+
+	// Datacenter is the name of the datacenter to use in the vCenter.
+	Datacenter string `json:"datacenter"`
+
+	// DefaultDatastore is the default datastore to use for provisioning
+	// volumes.
+	DefaultDatastore string `json:"defaultDatastore"`
+}
@@ -0,0 +1,25 @@
+package types
+
+// ReproducibleRender switches the installer into a mode where manifest
+// rendering is a pure function of install-config.yaml: TLS key material
+// and the cluster ID are derived from Seed instead of the system's
+// entropy source, so Manifests.Generate emits byte-identical output
+// across runs. This enables diffing rendered manifests in GitOps
+// pipelines and producing signed/attested release artifacts.
+//
+// WARNING: this downgrades every CA and leaf private key in the cluster
+// to material that anyone who knows or guesses Seed can reconstruct. It
+// is not cryptographically secure and must never be enabled for a real
+// cluster - only for platform-less dev/CI installs, where the installer
+// refuses to honor Seed at all once a real Platform is set.
+type ReproducibleRender struct {
+	// Seed is the deterministic entropy source for TLS key generation and
+	// cluster ID derivation. The INSTALLER_SEED environment variable
+	// overrides this field when set.
+	Seed string `json:"seed,omitempty"`
+
+	// NotBefore fixes the NotBefore time baked into generated
+	// certificates, as an RFC3339 timestamp. Defaults to a fixed epoch
+	// when Seed is set but NotBefore is not.
+	NotBefore string `json:"notBefore,omitempty"`
+}